@@ -17,10 +17,13 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/digitalocean/digitalocean-cloud-controller-manager/cloud-controller-manager/do"
+	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/util/wait"
 	cloudprovider "k8s.io/cloud-provider"
 	"k8s.io/cloud-provider/app"
@@ -49,6 +52,7 @@ func main() {
 		flag.NamedFlagSets{},
 		wait.NeverStop,
 	)
+	command.AddCommand(newSelfTestCommand())
 
 	logs.InitLogs()
 	defer logs.FlushLogs()
@@ -72,3 +76,35 @@ func doInitializer(cfg *config.CompletedConfig) cloudprovider.Interface {
 
 	return cloud
 }
+
+func newSelfTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "selftest",
+		Short: "Verify that the configured DO access token is valid and has the required permissions",
+		Long: `selftest issues read-only requests against the DO API using the access
+token and API settings from the environment. It exits with a non-zero
+status if any of the requests fail.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			return do.SelfTest(ctx)
+		},
+		// main prints the returned error, and the usage does not help with
+		// diagnosing a failed check.
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	// Do not inherit the usage and help functions of the root command, which
+	// list all cloud controller manager flags that selftest ignores.
+	cmd.SetUsageFunc(func(cmd *cobra.Command) error {
+		fmt.Fprintf(cmd.OutOrStderr(), "Usage:\n  %s\n", cmd.UseLine())
+		return nil
+	})
+	cmd.SetHelpFunc(func(cmd *cobra.Command, _ []string) {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\n\nUsage:\n  %s\n", cmd.Long, cmd.UseLine())
+	})
+
+	return cmd
+}
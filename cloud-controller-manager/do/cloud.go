@@ -57,6 +57,8 @@ const (
 	publicAccessFirewallTagsEnv string = "PUBLIC_ACCESS_FIREWALL_TAGS"
 	regionEnv                   string = "REGION"
 	doAPIRateLimitQPSEnv        string = "DO_API_RATE_LIMIT_QPS"
+	doAPIMaxConcurrentReqsEnv   string = "DO_API_MAX_CONCURRENT_REQUESTS"
+	doUserAgentEnv              string = "DO_USER_AGENT"
 )
 
 var version string
@@ -84,7 +86,8 @@ type cloud struct {
 	httpServer *http.Server
 }
 
-func newCloud() (cloudprovider.Interface, error) {
+// newGodoClient creates a godo client configured from the environment.
+func newGodoClient() (*godo.Client, error) {
 	token := os.Getenv(doAccessTokenEnv)
 
 	opts := []godo.ClientOpt{}
@@ -96,7 +99,11 @@ func newCloud() (cloudprovider.Interface, error) {
 	if version == "" {
 		version = "dev"
 	}
-	opts = append(opts, godo.SetUserAgent("digitalocean-cloud-controller-manager/"+version))
+	userAgent := "digitalocean-cloud-controller-manager/" + version
+	if userAgentOverride := os.Getenv(doUserAgentEnv); userAgentOverride != "" {
+		userAgent = userAgentOverride
+	}
+	opts = append(opts, godo.SetUserAgent(userAgent))
 
 	if token == "" {
 		return nil, fmt.Errorf("environment variable %q is required", doAccessTokenEnv)
@@ -116,10 +123,33 @@ func newCloud() (cloudprovider.Interface, error) {
 	}
 
 	oauthClient := oauth2.NewClient(oauth2.NoContext, tokenSource)
+
+	if maxReqsRaw := os.Getenv(doAPIMaxConcurrentReqsEnv); maxReqsRaw != "" {
+		maxReqs, err := strconv.Atoi(maxReqsRaw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value from environment variable %s: %s", doAPIMaxConcurrentReqsEnv, err)
+		}
+		if maxReqs <= 0 {
+			return nil, fmt.Errorf("environment variable %s must be a positive integer, got %d", doAPIMaxConcurrentReqsEnv, maxReqs)
+		}
+		klog.Infof("Limiting concurrent DO API requests to %d", maxReqs)
+		oauthClient.Transport = newConcurrencyLimitedTransport(oauthClient.Transport, maxReqs)
+	}
+
 	doClient, err := godo.New(oauthClient, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create godo client: %s", err)
 	}
+	doClient.OnRequestCompleted(recordAPIRateLimit)
+
+	return doClient, nil
+}
+
+func newCloud() (cloudprovider.Interface, error) {
+	doClient, err := newGodoClient()
+	if err != nil {
+		return nil, err
+	}
 
 	region, err := dropletRegion(doClient.Regions)
 	if err != nil {
@@ -236,6 +266,8 @@ func (c *cloud) serveMetrics() {
 	prometheus.MustRegister(resourceSyncsTotal)
 	prometheus.MustRegister(reconcileDuration)
 	prometheus.MustRegister(reconcilesTotal)
+	prometheus.MustRegister(apiRateLimit)
+	prometheus.MustRegister(apiRateLimitRemaining)
 
 	if err := http.ListenAndServe(c.metrics.host, nil); err != http.ErrServerClosed {
 		klog.Warningf("Metrics server has not been configured: %s", err)
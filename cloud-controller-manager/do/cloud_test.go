@@ -0,0 +1,157 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewGodoClientUserAgent(t *testing.T) {
+	tests := []struct {
+		name              string
+		userAgentOverride string
+		wantPrefix        string
+	}{
+		{
+			name:       "default",
+			wantPrefix: "digitalocean-cloud-controller-manager/dev ",
+		},
+		{
+			name:              "override",
+			userAgentOverride: "my-ccm/1.2.3",
+			wantPrefix:        "my-ccm/1.2.3 ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var gotUserAgent string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserAgent = r.UserAgent()
+				w.Write([]byte(`{"account": null}`))
+			}))
+			defer ts.Close()
+
+			origVersion := version
+			version = "dev"
+			defer func() { version = origVersion }()
+
+			t.Setenv(doAccessTokenEnv, "token")
+			t.Setenv(doOverrideAPIURLEnv, ts.URL)
+			t.Setenv(doUserAgentEnv, test.userAgentOverride)
+
+			client, err := newGodoClient()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := client.Account.Get(context.Background()); err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !strings.HasPrefix(gotUserAgent, test.wantPrefix) {
+				t.Errorf("got user agent %q, want prefix %q", gotUserAgent, test.wantPrefix)
+			}
+		})
+	}
+}
+
+func TestNewGodoClientMaxConcurrentRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxReqs     string
+		wantErr     string
+		wantMaxReqs int32
+	}{
+		{
+			name:    "non-integer",
+			maxReqs: "abc",
+			wantErr: "failed to parse value from environment variable DO_API_MAX_CONCURRENT_REQUESTS",
+		},
+		{
+			name:    "zero",
+			maxReqs: "0",
+			wantErr: "environment variable DO_API_MAX_CONCURRENT_REQUESTS must be a positive integer, got 0",
+		},
+		{
+			name:    "negative",
+			maxReqs: "-1",
+			wantErr: "environment variable DO_API_MAX_CONCURRENT_REQUESTS must be a positive integer, got -1",
+		},
+		{
+			name:        "valid limit",
+			maxReqs:     "2",
+			wantMaxReqs: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var inFlight, maxInFlight int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				cur := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if cur <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, cur) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				w.Write([]byte(`{"account": null}`))
+			}))
+			defer ts.Close()
+
+			t.Setenv(doAccessTokenEnv, "token")
+			t.Setenv(doOverrideAPIURLEnv, ts.URL)
+			t.Setenv(doAPIMaxConcurrentReqsEnv, test.maxReqs)
+
+			client, err := newGodoClient()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, _, err := client.Account.Get(context.Background()); err != nil {
+						t.Errorf("got unexpected error: %s", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := atomic.LoadInt32(&maxInFlight); got != test.wantMaxReqs {
+				t.Errorf("got max in-flight requests %d, want %d", got, test.wantMaxReqs)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/digitalocean/godo"
 	v1 "k8s.io/api/core/v1"
@@ -28,6 +29,33 @@ import (
 // apiResultsPerPage is the maximum page size that DigitalOcean's api supports.
 const apiResultsPerPage = 200
 
+var (
+	// errGodoNotFound indicates that the requested DO resource does not exist.
+	errGodoNotFound = errors.New("DO API resource not found")
+	// errGodoUnavailable indicates that the DO API is temporarily
+	// unavailable, e.g., during maintenance.
+	errGodoUnavailable = errors.New("DO API temporarily unavailable")
+)
+
+// classifyGodoError maps an error returned by godo to one of the errGodo*
+// sentinel errors based on the HTTP status code of the response. It returns
+// nil if err is not a godo error response or does not fall into a known
+// class.
+func classifyGodoError(err error) error {
+	var errResp *godo.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return nil
+	}
+
+	switch errResp.Response.StatusCode {
+	case http.StatusNotFound:
+		return errGodoNotFound
+	case http.StatusServiceUnavailable:
+		return errGodoUnavailable
+	}
+	return nil
+}
+
 func allDropletList(ctx context.Context, client *godo.Client) ([]godo.Droplet, error) {
 	list := []godo.Droplet{}
 
@@ -19,6 +19,8 @@ package do
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -63,13 +65,17 @@ func newFakeResponse(statusCode int) *godo.Response {
 }
 
 func newFakeNotFoundErrorResponse() *godo.ErrorResponse {
+	return newFakeErrorResponse(http.StatusNotFound)
+}
+
+func newFakeErrorResponse(statusCode int) *godo.ErrorResponse {
 	return &godo.ErrorResponse{
 		Response: &http.Response{
 			Request: &http.Request{
 				Method: "FAKE",
 				URL:    &url.URL{},
 			},
-			StatusCode: http.StatusNotFound,
+			StatusCode: statusCode,
 			Body:       ioutil.NopCloser(bytes.NewBufferString("test")),
 		},
 	}
@@ -165,3 +171,45 @@ func TestAllLoadBalancerList(t *testing.T) {
 		t.Errorf("incorrect lbs\nwant: %#v\n got: %#v", want, got)
 	}
 }
+
+func TestClassifyGodoError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "not found",
+			err:  newFakeNotFoundErrorResponse(),
+			want: errGodoNotFound,
+		},
+		{
+			name: "unavailable",
+			err:  newFakeErrorResponse(http.StatusServiceUnavailable),
+			want: errGodoUnavailable,
+		},
+		{
+			name: "wrapped not found",
+			err:  fmt.Errorf("failed to get droplet: %w", newFakeNotFoundErrorResponse()),
+			want: errGodoNotFound,
+		},
+		{
+			name: "unclassified status code",
+			err:  newFakeErrorResponse(http.StatusInternalServerError),
+			want: nil,
+		},
+		{
+			name: "non-godo error",
+			err:  errors.New("connection refused"),
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := classifyGodoError(test.err); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// concurrencyLimitedTransport is an http.RoundTripper that caps the number of
+// in-flight requests. Requests beyond the limit wait for a free slot until
+// their context is done.
+type concurrencyLimitedTransport struct {
+	next http.RoundTripper
+	sem  chan struct{}
+}
+
+func newConcurrencyLimitedTransport(next http.RoundTripper, limit int) *concurrencyLimitedTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &concurrencyLimitedTransport{
+		next: next,
+		sem:  make(chan struct{}, limit),
+	}
+}
+
+func (t *concurrencyLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	// Hold on to the slot until the response body has been consumed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+func (t *concurrencyLimitedTransport) release() {
+	<-t.sem
+}
+
+// releasingBody calls release exactly once when the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
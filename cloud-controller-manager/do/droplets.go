@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 
@@ -147,12 +146,7 @@ func (i *instances) InstanceExistsByProviderID(ctx context.Context, providerID s
 		return true, nil
 	}
 
-	godoErr, ok := err.(*godo.ErrorResponse)
-	if !ok {
-		return false, fmt.Errorf("unexpected error type %T from godo: %s", err, err)
-	}
-
-	if godoErr.Response.StatusCode != http.StatusNotFound {
+	if classifyGodoError(err) != errGodoNotFound {
 		return false, fmt.Errorf("error checking if instance exists: %s", err)
 	}
 
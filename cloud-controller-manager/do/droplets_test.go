@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -302,6 +304,63 @@ func Test_InstanceShutdownByProviderID(t *testing.T) {
 	}
 }
 
+func Test_InstanceExistsByProviderID(t *testing.T) {
+	serverErr := newFakeErrorResponse(http.StatusInternalServerError)
+	serverErr.Message = "internal error"
+	serverErr.RequestID = "6fa8b9a6-8c61-4c88-8e9e-dc3e8bd0a2f1"
+
+	tests := []struct {
+		name       string
+		getErr     error
+		wantExists bool
+		wantErr    string
+	}{
+		{
+			name:       "droplet exists",
+			wantExists: true,
+		},
+		{
+			name:       "droplet not found",
+			getErr:     newFakeNotFoundErrorResponse(),
+			wantExists: false,
+		},
+		{
+			name:    "error includes DO request ID",
+			getErr:  serverErr,
+			wantErr: `(request "6fa8b9a6-8c61-4c88-8e9e-dc3e8bd0a2f1") internal error`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &fakeDropletService{}
+			fake.getFunc = func(ctx context.Context, dropletID int) (*godo.Droplet, *godo.Response, error) {
+				if test.getErr != nil {
+					return nil, newFakeNotOKResponse(), test.getErr
+				}
+				return newFakeDroplet(), newFakeOKResponse(), nil
+			}
+
+			res := &resources{gclient: newFakeDropletClient(fake)}
+			instances := newInstances(res, "nyc1")
+
+			exists, err := instances.InstanceExistsByProviderID(context.TODO(), "digitalocean://123")
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if exists != test.wantExists {
+				t.Errorf("got exists %t, want %t", exists, test.wantExists)
+			}
+		})
+	}
+}
+
 func Test_dropletIDFromProviderID(t *testing.T) {
 	testcases := []struct {
 		name       string
@@ -109,7 +109,9 @@ func (f *fakeTagsService) TagResources(ctx context.Context, name string, tagRequ
 	}
 
 	if !f.tags[name] {
-		return newFakeResponse(http.StatusNotFound), fmt.Errorf("tag %q does not exist", name)
+		errResp := newFakeNotFoundErrorResponse()
+		errResp.Message = fmt.Sprintf("tag %q does not exist", name)
+		return newFakeResponse(http.StatusNotFound), errResp
 	}
 
 	f.tagRequests = append(f.tagRequests, tagRequest)
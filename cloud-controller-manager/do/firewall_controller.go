@@ -19,7 +19,6 @@ package do
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -200,11 +199,11 @@ func (fm *firewallManager) Get(ctx context.Context) (fw *godo.Firewall, err erro
 	// it exists. Return it. If not, continue.
 	fw, _ = fm.fwCache.getCachedFirewall()
 	if fw != nil {
-		fw, resp, err := fm.executeInstrumentedFirewallOperationGetByID(ctx, fw.ID)
-		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
-			return nil, fmt.Errorf("could not get firewall: %v", err)
-		}
-		if resp.StatusCode == http.StatusNotFound {
+		fw, _, err := fm.executeInstrumentedFirewallOperationGetByID(ctx, fw.ID)
+		if err != nil {
+			if classifyGodoError(err) != errGodoNotFound {
+				return nil, fmt.Errorf("could not get firewall: %v", err)
+			}
 			klog.Warning("unable to retrieve firewall by ID because it no longer exists")
 		}
 		if fw != nil {
@@ -239,13 +238,12 @@ func (fm *firewallManager) Set(ctx context.Context, fwID string, fr *godo.Firewa
 	}()
 
 	if fwID != "" {
-		var resp *godo.Response
-		currentFirewall, resp, err = fm.updateFirewall(ctx, fwID, fr)
+		currentFirewall, _, err = fm.updateFirewall(ctx, fwID, fr)
 		if err == nil {
 			klog.Info("successfully updated firewall")
 			return nil
 		}
-		if resp == nil || resp.StatusCode != http.StatusNotFound {
+		if classifyGodoError(err) != errGodoNotFound {
 			return fmt.Errorf("failed to update firewall: %v", err)
 		}
 	}
@@ -64,7 +64,7 @@ func TestFirewallController_Get(t *testing.T) {
 			name:    "firewall does not exist in API",
 			fwCache: newFakeFirewallCache(),
 			expectedGodoFirewallGetResp: func(context.Context, string) (*godo.Firewall, *godo.Response, error) {
-				return nil, newFakeNotFoundResponse(), errors.New("not found")
+				return nil, newFakeNotFoundResponse(), newFakeNotFoundErrorResponse()
 			},
 			expectedGodoFirewallListResp: func(context.Context, *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
 				return nil, newFakeOKResponse(), nil
@@ -74,7 +74,7 @@ func TestFirewallController_Get(t *testing.T) {
 			name:    "handle 404 response code from GET firewall by ID and instead return firewall from List",
 			fwCache: newFakeFirewallCache(),
 			expectedGodoFirewallGetResp: func(context.Context, string) (*godo.Firewall, *godo.Response, error) {
-				return nil, newFakeNotFoundResponse(), errors.New("not found")
+				return nil, newFakeNotFoundResponse(), newFakeNotFoundErrorResponse()
 			},
 			expectedGodoFirewallListResp: func(context.Context, *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
 				return []godo.Firewall{*newFakeFirewall()}, newFakeOKResponse(), nil
@@ -157,7 +157,7 @@ func TestFirewallController_Set(t *testing.T) {
 			fwID: "id",
 			fakeOverrides: fakeFirewallService{
 				updateFunc: func(context.Context, string, *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
-					return nil, newFakeNotFoundResponse(), errors.New("not found")
+					return nil, newFakeNotFoundResponse(), newFakeNotFoundErrorResponse()
 				},
 				createFunc: func(context.Context, *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
 					return newFakeFirewall(), newFakeOKResponse(), nil
@@ -37,6 +37,15 @@ func (c *godoHealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 	_, _, err := c.client.Account.Get(ctx)
 	if err != nil {
+		// A 503 indicates that the DO API is temporarily down, e.g., during
+		// maintenance. Pass it on so that callers can tell it apart from a
+		// persistent failure and retry later.
+		if classifyGodoError(err) == errGodoUnavailable {
+			msg := fmt.Sprintf("health check failed: DO API is temporarily unavailable (possibly under maintenance): %s", err)
+			klog.Warning(msg)
+			http.Error(w, msg, http.StatusServiceUnavailable)
+			return
+		}
 		msg := fmt.Sprintf("health check failed: %s", err)
 		klog.Error(msg)
 		http.Error(w, msg, http.StatusInternalServerError)
@@ -49,6 +49,14 @@ func TestGodoHealthChecker(t *testing.T) {
 			wantCode: http.StatusInternalServerError,
 			wantBody: "not you",
 		},
+		{
+			name: "maintenance",
+			stubHandler: func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, `{"id": "service_unavailable", "message": "API is under maintenance"}`, http.StatusServiceUnavailable)
+			},
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: "temporarily unavailable",
+		},
 	}
 
 	for _, test := range tests {
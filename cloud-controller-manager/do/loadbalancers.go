@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"reflect"
 	"sort"
 	"strconv"
@@ -389,8 +388,7 @@ func (l *loadBalancers) recordUpdatedLetsEncryptCert(ctx context.Context, servic
 	if lbCertID != "" && lbCertID != serviceCertID {
 		lbCert, _, err := l.resources.gclient.Certificates.Get(ctx, lbCertID)
 		if err != nil {
-			respErr, ok := err.(*godo.ErrorResponse)
-			if ok && respErr.Response.StatusCode == http.StatusNotFound {
+			if classifyGodoError(err) == errGodoNotFound {
 				return nil
 			}
 			return fmt.Errorf("failed to get DO certificate for load-balancer: %s", err)
@@ -488,9 +486,9 @@ func (l *loadBalancers) EnsureLoadBalancerDeleted(ctx context.Context, clusterNa
 		return err
 	}
 
-	resp, err := l.resources.gclient.LoadBalancers.Delete(ctx, lb.ID)
+	_, err = l.resources.gclient.LoadBalancers.Delete(ctx, lb.ID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if classifyGodoError(err) == errGodoNotFound {
 			return nil
 		}
 		return fmt.Errorf("failed to delete load-balancer: %s", err)
@@ -534,9 +532,9 @@ func (l *loadBalancers) retrieveLoadBalancer(ctx context.Context, service *v1.Se
 }
 
 func (l *loadBalancers) findLoadBalancerByID(ctx context.Context, id string) (*godo.LoadBalancer, error) {
-	lb, resp, err := l.resources.gclient.LoadBalancers.Get(ctx, id)
+	lb, _, err := l.resources.gclient.LoadBalancers.Get(ctx, id)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if classifyGodoError(err) == errGodoNotFound {
 			return nil, errLBNotFound
 		}
 
@@ -5079,7 +5079,7 @@ func Test_EnsureLoadBalancer(t *testing.T) {
 				},
 			},
 			getFn: func(context.Context, string) (*godo.LoadBalancer, *godo.Response, error) {
-				return nil, newFakeResponse(http.StatusNotFound), errors.New("LB not found")
+				return nil, newFakeResponse(http.StatusNotFound), newFakeNotFoundErrorResponse()
 			},
 			listFn: func(context.Context, *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
 				return nil, newFakeNotOKResponse(), errors.New("list should not have been invoked")
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -29,44 +30,59 @@ import (
 	"k8s.io/klog/v2"
 )
 
-const (
+const resultsPerPage = 50
+
+var (
 	dropletRegionMetadataURL = "http://169.254.169.254/metadata/v1/region"
-	resultsPerPage           = 50
+
+	// metadataTimeout bounds droplet metadata lookups so that a slow or
+	// unavailable metadata service does not block startup indefinitely.
+	metadataTimeout = 10 * time.Second
 )
 
 // dropletRegion returns the region of the currently running program.
 func dropletRegion(regionsService godo.RegionsService) (string, error) {
 	region := os.Getenv(regionEnv)
 	if region == "" {
-		return httpGet(dropletRegionMetadataURL)
+		var err error
+		region, err = httpGet(dropletRegionMetadataURL)
+		if err != nil {
+			return "", fmt.Errorf("failed to get region from droplet metadata (set environment variable %s to skip the lookup): %s", regionEnv, err)
+		}
+		klog.Infof("Using region %q from droplet metadata", region)
+		return region, nil
 	}
 
-	validRegion, err := isValidRegion(region, regionsService)
+	regions, err := listAllRegions(regionsService)
 	if err != nil {
 		return "", fmt.Errorf("failed to determine if region is valid: %s", err)
 	}
-	if !validRegion {
-		return "", fmt.Errorf("invalid region specified: %s", region)
+	if !isValidRegion(region, regions) {
+		return "", fmt.Errorf("invalid region specified: %s (valid regions: %s)", region, strings.Join(regionSlugs(regions), ", "))
 	}
 
 	klog.Infof("Using region %q from environment variable", region)
 	return region, nil
 }
 
-// isValidRegion checks whether the given region is a valid DO region
-func isValidRegion(region string, regionsService godo.RegionsService) (bool, error) {
-	regions, err := listAllRegions(regionsService)
-	if err != nil {
-		return false, err
-	}
-
+// isValidRegion checks whether the given region is among the given DO regions
+func isValidRegion(region string, regions []godo.Region) bool {
 	for _, reg := range regions {
 		if reg.Slug == region {
-			return true, nil
+			return true
 		}
 	}
 
-	return false, nil
+	return false
+}
+
+// regionSlugs returns the slugs of the given regions.
+func regionSlugs(regions []godo.Region) []string {
+	slugs := make([]string, 0, len(regions))
+	for _, reg := range regions {
+		slugs = append(slugs, reg.Slug)
+	}
+	return slugs
 }
 
 func listAllRegions(regionsService godo.RegionsService) ([]godo.Region, error) {
@@ -115,7 +131,8 @@ func listAllRegions(regionsService godo.RegionsService) ([]godo.Region, error) {
 //
 //	e.g. http://169.254.169.254/metadata/v1/id"
 func httpGet(url string) (string, error) {
-	resp, err := http.Get(url)
+	client := &http.Client{Timeout: metadataTimeout}
+	resp, err := client.Get(url)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+type fakeRegionsService struct {
+	listFunc func(ctx context.Context, opt *godo.ListOptions) ([]godo.Region, *godo.Response, error)
+}
+
+func (f *fakeRegionsService) List(ctx context.Context, opt *godo.ListOptions) ([]godo.Region, *godo.Response, error) {
+	return f.listFunc(ctx, opt)
+}
+
+func TestDropletRegion(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		listFunc      func(ctx context.Context, opt *godo.ListOptions) ([]godo.Region, *godo.Response, error)
+		metadataDelay time.Duration
+		wantRegion    string
+		wantErr       string
+	}{
+		{
+			name:       "region from metadata",
+			wantRegion: "ams3",
+		},
+		{
+			name:          "slow metadata service",
+			metadataDelay: time.Second,
+			wantErr:       "failed to get region from droplet metadata (set environment variable REGION to skip the lookup)",
+		},
+		{
+			name:   "valid region",
+			region: "nyc3",
+			listFunc: func(context.Context, *godo.ListOptions) ([]godo.Region, *godo.Response, error) {
+				return []godo.Region{{Slug: "nyc3"}, {Slug: "sfo2"}}, newFakeOKResponse(), nil
+			},
+			wantRegion: "nyc3",
+		},
+		{
+			name:   "invalid region",
+			region: "mars1",
+			listFunc: func(context.Context, *godo.ListOptions) ([]godo.Region, *godo.Response, error) {
+				return []godo.Region{{Slug: "nyc3"}, {Slug: "sfo2"}}, newFakeOKResponse(), nil
+			},
+			wantErr: "invalid region specified: mars1 (valid regions: nyc3, sfo2)",
+		},
+		{
+			name:   "listing regions fails",
+			region: "nyc3",
+			listFunc: func(context.Context, *godo.ListOptions) ([]godo.Region, *godo.Response, error) {
+				return nil, newFakeNotOKResponse(), errors.New("regions unavailable")
+			},
+			wantErr: "failed to determine if region is valid: regions list request failed: regions unavailable",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done := make(chan struct{})
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				select {
+				case <-time.After(test.metadataDelay):
+				case <-done:
+				}
+				w.Write([]byte("ams3"))
+			}))
+			defer ts.Close()
+			defer close(done)
+
+			origURL, origTimeout := dropletRegionMetadataURL, metadataTimeout
+			dropletRegionMetadataURL, metadataTimeout = ts.URL, 50*time.Millisecond
+			defer func() {
+				dropletRegionMetadataURL, metadataTimeout = origURL, origTimeout
+			}()
+
+			t.Setenv(regionEnv, test.region)
+
+			gotRegion, err := dropletRegion(&fakeRegionsService{listFunc: test.listFunc})
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if gotRegion != test.wantRegion {
+				t.Errorf("got region %q, want %q", gotRegion, test.wantRegion)
+			}
+		})
+	}
+}
+
+func TestHTTPGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		delay    time.Duration
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "fast response",
+			wantBody: "nyc3",
+		},
+		{
+			name:    "slow response times out",
+			delay:   time.Second,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done := make(chan struct{})
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				select {
+				case <-time.After(test.delay):
+				case <-done:
+				}
+				w.Write([]byte("nyc3"))
+			}))
+			defer ts.Close()
+			defer close(done)
+
+			origTimeout := metadataTimeout
+			metadataTimeout = 50 * time.Millisecond
+			defer func() { metadataTimeout = origTimeout }()
+
+			gotBody, err := httpGet(ts.URL)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("got no error, want one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if gotBody != test.wantBody {
+				t.Errorf("got body %q, want %q", gotBody, test.wantBody)
+			}
+		})
+	}
+}
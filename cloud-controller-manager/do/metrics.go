@@ -16,7 +16,12 @@ limitations under the License.
 
 package do
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 type firewallOperation string
 
@@ -99,6 +104,22 @@ var (
 		},
 		[]string{"result", "error_type"},
 	)
+	apiRateLimit = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "do",
+			Subsystem: "api",
+			Name:      "rate_limit",
+			Help:      "The number of DO API requests per hour the account is limited to.",
+		},
+	)
+	apiRateLimitRemaining = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "do",
+			Subsystem: "api",
+			Name:      "rate_limit_remaining",
+			Help:      "The number of DO API requests remaining in the current rate limit window.",
+		},
+	)
 )
 
 func newMetrics(host string) metrics {
@@ -112,3 +133,15 @@ func newMetrics(host string) metrics {
 		reconcilesTotal:      reconcilesTotal,
 	}
 }
+
+// recordAPIRateLimit updates the DO API rate limit gauges from the rate limit
+// headers of the given response. It is meant to be registered as a godo
+// request completion callback.
+func recordAPIRateLimit(_ *http.Request, resp *http.Response) {
+	if limit, err := strconv.Atoi(resp.Header.Get("RateLimit-Limit")); err == nil {
+		apiRateLimit.Set(float64(limit))
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("RateLimit-Remaining")); err == nil {
+		apiRateLimitRemaining.Set(float64(remaining))
+	}
+}
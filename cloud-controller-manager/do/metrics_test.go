@@ -0,0 +1,53 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecordAPIRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Limit", "5000")
+		w.Header().Set("RateLimit-Remaining", "4321")
+		w.Write([]byte(`{"account": null}`))
+	}))
+	defer ts.Close()
+
+	client, err := godo.New(http.DefaultClient, godo.SetBaseURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.OnRequestCompleted(recordAPIRateLimit)
+
+	if _, _, err := client.Account.Get(context.Background()); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if got, want := testutil.ToFloat64(apiRateLimit), float64(5000); got != want {
+		t.Errorf("got rate limit %v, want %v", got, want)
+	}
+	if got, want := testutil.ToFloat64(apiRateLimitRemaining), float64(4321); got != want {
+		t.Errorf("got rate limit remaining %v, want %v", got, want)
+	}
+}
@@ -19,7 +19,6 @@ package do
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/digitalocean/godo"
@@ -211,11 +210,11 @@ func (r *ResourcesController) tagResources(res []godo.Resource) error {
 	ctx, cancel := context.WithTimeout(context.Background(), syncTagsTimeout)
 	defer cancel()
 	tag := buildK8sTag(r.resources.clusterID)
-	resp, err := r.resources.gclient.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{
+	_, err := r.resources.gclient.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{
 		Resources: res,
 	})
 
-	if resp != nil && resp.StatusCode == http.StatusNotFound {
+	if classifyGodoError(err) == errGodoNotFound {
 		return tagMissingError{fmt.Errorf("tag %q does not exist", tag)}
 	}
 
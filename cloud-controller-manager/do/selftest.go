@@ -0,0 +1,100 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/digitalocean/godo"
+	"k8s.io/klog/v2"
+)
+
+// SelfTest verifies that the DO access token configured in the environment is
+// valid and grants read access to the resources managed by the cloud
+// controller manager. Only read-only requests are issued.
+func SelfTest(ctx context.Context) error {
+	client, err := newGodoClient()
+	if err != nil {
+		return err
+	}
+
+	return selfTest(ctx, client, os.Getenv(publicAccessFirewallNameEnv) != "")
+}
+
+// selfTestCheck is a single read-only DO API request run by the self-test.
+type selfTestCheck struct {
+	name string
+	run  func(ctx context.Context) (*godo.Response, error)
+}
+
+func selfTest(ctx context.Context, client *godo.Client, checkFirewalls bool) error {
+	listOpts := &godo.ListOptions{Page: 1, PerPage: 1}
+
+	checks := []selfTestCheck{
+		{
+			name: "get account",
+			run: func(ctx context.Context) (*godo.Response, error) {
+				_, resp, err := client.Account.Get(ctx)
+				return resp, err
+			},
+		},
+		{
+			name: "list droplets",
+			run: func(ctx context.Context) (*godo.Response, error) {
+				_, resp, err := client.Droplets.List(ctx, listOpts)
+				return resp, err
+			},
+		},
+		{
+			name: "list load-balancers",
+			run: func(ctx context.Context) (*godo.Response, error) {
+				_, resp, err := client.LoadBalancers.List(ctx, listOpts)
+				return resp, err
+			},
+		},
+	}
+	if checkFirewalls {
+		checks = append(checks, selfTestCheck{
+			name: "list firewalls",
+			run: func(ctx context.Context) (*godo.Response, error) {
+				_, resp, err := client.Firewalls.List(ctx, listOpts)
+				return resp, err
+			},
+		})
+	}
+
+	for _, check := range checks {
+		resp, err := check.run(ctx)
+		if err != nil {
+			if resp != nil {
+				switch resp.StatusCode {
+				case http.StatusUnauthorized:
+					return fmt.Errorf("self-test %q failed: access token is invalid or expired: %s", check.name, err)
+				case http.StatusForbidden:
+					return fmt.Errorf("self-test %q failed: access token lacks the required scope (read and write access is needed): %s", check.name, err)
+				}
+			}
+			return fmt.Errorf("self-test %q failed: %s", check.name, err)
+		}
+		klog.Infof("Self-test %q passed", check.name)
+	}
+
+	return nil
+}
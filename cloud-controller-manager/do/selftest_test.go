@@ -0,0 +1,120 @@
+/*
+Copyright 2022 DigitalOcean
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+type fakeAccountService struct {
+	getFunc func(ctx context.Context) (*godo.Account, *godo.Response, error)
+}
+
+func (f *fakeAccountService) Get(ctx context.Context) (*godo.Account, *godo.Response, error) {
+	return f.getFunc(ctx)
+}
+
+func TestSelfTest(t *testing.T) {
+	accountOK := func(context.Context) (*godo.Account, *godo.Response, error) {
+		return &godo.Account{}, newFakeOKResponse(), nil
+	}
+	dropletsOK := func(context.Context, *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+		return nil, newFakeOKResponse(), nil
+	}
+	lbsOK := func(context.Context, *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+		return nil, newFakeOKResponse(), nil
+	}
+
+	tests := []struct {
+		name           string
+		accountGet     func(context.Context) (*godo.Account, *godo.Response, error)
+		dropletsList   func(context.Context, *godo.ListOptions) ([]godo.Droplet, *godo.Response, error)
+		lbsList        func(context.Context, *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error)
+		firewallsList  func(context.Context, *godo.ListOptions) ([]godo.Firewall, *godo.Response, error)
+		checkFirewalls bool
+		wantErr        string
+	}{
+		{
+			name:         "all checks pass",
+			accountGet:   accountOK,
+			dropletsList: dropletsOK,
+			lbsList:      lbsOK,
+		},
+		{
+			name: "invalid token",
+			accountGet: func(context.Context) (*godo.Account, *godo.Response, error) {
+				return nil, newFakeResponse(http.StatusUnauthorized), newFakeErrorResponse(http.StatusUnauthorized)
+			},
+			wantErr: `self-test "get account" failed: access token is invalid or expired`,
+		},
+		{
+			name:       "missing scope",
+			accountGet: accountOK,
+			dropletsList: func(context.Context, *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+				return nil, newFakeResponse(http.StatusForbidden), newFakeErrorResponse(http.StatusForbidden)
+			},
+			wantErr: `self-test "list droplets" failed: access token lacks the required scope`,
+		},
+		{
+			name:         "generic failure",
+			accountGet:   accountOK,
+			dropletsList: dropletsOK,
+			lbsList: func(context.Context, *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+				return nil, newFakeNotOKResponse(), newFakeErrorResponse(http.StatusInternalServerError)
+			},
+			wantErr: `self-test "list load-balancers" failed`,
+		},
+		{
+			name:         "firewalls checked when managed",
+			accountGet:   accountOK,
+			dropletsList: dropletsOK,
+			lbsList:      lbsOK,
+			firewallsList: func(context.Context, *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
+				return nil, newFakeResponse(http.StatusForbidden), newFakeErrorResponse(http.StatusForbidden)
+			},
+			checkFirewalls: true,
+			wantErr:        `self-test "list firewalls" failed: access token lacks the required scope`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &godo.Client{
+				Account:       &fakeAccountService{getFunc: test.accountGet},
+				Droplets:      &fakeDropletService{listFunc: test.dropletsList},
+				LoadBalancers: &fakeLBService{listFn: test.lbsList},
+				Firewalls:     &fakeFirewallService{listFunc: test.firewallsList},
+			}
+
+			err := selfTest(context.Background(), client, test.checkFirewalls)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("got error %v, want error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}